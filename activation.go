@@ -0,0 +1,70 @@
+package main
+
+import "math"
+
+// ActivationFunc turns a perceptron's weighted sum into its output signal.
+// Swapping it lets the same Perceptron type reproduce the classic
+// Heaviside-step classifier as well as the smoother, differentiable
+// activations used elsewhere in machine learning.
+//
+// Fn computes the activated output from the weighted sum. Positive reports
+// whether a given output counts as class 1 rather than class 0 — this
+// varies by activation, since e.g. Sigmoid's outputs range over (0, 1)
+// while Sign's range over {-1, 1}, so Predict cannot simply truncate Fn's
+// result toward zero.
+type ActivationFunc struct {
+	Name     string
+	Fn       func(sum float32) float32
+	Positive func(output float32) bool
+}
+
+// Step is the classic Heaviside step function: 0 below zero, 1 at or above it.
+var Step = ActivationFunc{
+	Name: "step",
+	Fn: func(sum float32) float32 {
+		if sum < 0 {
+			return 0
+		}
+		return 1
+	},
+	Positive: func(output float32) bool {
+		return output >= 0.5
+	},
+}
+
+// Sign is like Step but returns -1 instead of 0 for negative sums, which
+// matches the +1/-1 target encoding used by many textbook perceptrons.
+var Sign = ActivationFunc{
+	Name: "sign",
+	Fn: func(sum float32) float32 {
+		if sum < 0 {
+			return -1
+		}
+		return 1
+	},
+	Positive: func(output float32) bool {
+		return output >= 0
+	},
+}
+
+// Sigmoid squashes its input into (0, 1).
+var Sigmoid = ActivationFunc{
+	Name: "sigmoid",
+	Fn: func(sum float32) float32 {
+		return float32(1 / (1 + math.Exp(-float64(sum))))
+	},
+	Positive: func(output float32) bool {
+		return output >= 0.5
+	},
+}
+
+// Tanh squashes its input into (-1, 1).
+var Tanh = ActivationFunc{
+	Name: "tanh",
+	Fn: func(sum float32) float32 {
+		return float32(math.Tanh(float64(sum)))
+	},
+	Positive: func(output float32) bool {
+		return output >= 0
+	},
+}
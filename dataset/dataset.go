@@ -0,0 +1,333 @@
+// Package dataset provides labeled example sources shared by the
+// perceptron demos: generators like LinearSeparableDataset and XORDataset,
+// file loaders like CSVDataset and LIBSVMDataset, and the ConfusionMatrix
+// report verify uses to summarize how well a classifier did.
+package dataset
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Dataset abstracts away where labeled training examples come from, so
+// train and verify no longer have to know whether they are drawing random
+// points from the above/below-the-line teacher, reading a CSV file, or
+// replaying a fixed set of examples such as XOR.
+//
+// Next returns the next (features, label) pair and ok=false once the
+// dataset is exhausted. Reset rewinds the dataset so it can be iterated
+// again, which train needs to run multiple epochs over the same data.
+type Dataset interface {
+	Next() (features []float32, label int32, ok bool)
+	Reset()
+}
+
+// LineDataset is an optional interface a Dataset can implement to expose
+// the 2D separation line it was generated from, so verify can draw it.
+type LineDataset interface {
+	Line() (a, b int32)
+}
+
+/* ### Generated datasets */
+
+// LinearSeparableDataset reproduces the original demo's teacher: it
+// generates size random 2D points labeled by whether they lie above or
+// below the line y = a*x + b.
+type LinearSeparableDataset struct {
+	A, B int32
+	Size int
+
+	i int
+}
+
+func (d *LinearSeparableDataset) Line() (a, b int32) {
+	return d.A, d.B
+}
+
+func (d *LinearSeparableDataset) Reset() {
+	d.i = 0
+}
+
+func (d *LinearSeparableDataset) Next() ([]float32, int32, bool) {
+	if d.i >= d.Size {
+		return nil, 0, false
+	}
+	d.i++
+
+	x := rand.Int31n(201) - 100
+	y := rand.Int31n(201) - 100
+	label := int32(0)
+	if y > d.A*x+d.B {
+		label = 1
+	}
+	return []float32{float32(x), float32(y)}, label, true
+}
+
+// CircleDataset generates size random 2D points labeled by whether they
+// lie inside a circle of the given radius centered on the origin. Unlike
+// LinearSeparableDataset, the classes it produces are not linearly
+// separable by a single perceptron once points straddle the curve closely.
+type CircleDataset struct {
+	Radius float32
+	Size   int
+
+	i int
+}
+
+func (d *CircleDataset) Reset() {
+	d.i = 0
+}
+
+func (d *CircleDataset) Next() ([]float32, int32, bool) {
+	if d.i >= d.Size {
+		return nil, 0, false
+	}
+	d.i++
+
+	x := rand.Float32()*200 - 100
+	y := rand.Float32()*200 - 100
+	label := int32(0)
+	if x*x+y*y < d.Radius*d.Radius {
+		label = 1
+	}
+	return []float32{x, y}, label, true
+}
+
+// XORDataset yields the four XOR examples once per pass. XOR is the textbook
+// example of a problem a single perceptron cannot learn, because no
+// straight line separates its two classes.
+type XORDataset struct {
+	i int
+}
+
+var xorExamples = []struct {
+	features []float32
+	label    int32
+}{
+	{[]float32{0, 0}, 0},
+	{[]float32{0, 1}, 1},
+	{[]float32{1, 0}, 1},
+	{[]float32{1, 1}, 0},
+}
+
+func (d *XORDataset) Reset() {
+	d.i = 0
+}
+
+func (d *XORDataset) Next() ([]float32, int32, bool) {
+	if d.i >= len(xorExamples) {
+		return nil, 0, false
+	}
+	example := xorExamples[d.i]
+	d.i++
+	return example.features, example.label, true
+}
+
+// Float64Examples drains ds into the [][]float64 input/target slices that
+// neural.Network.Train expects, since Dataset deals in float32 features to
+// match the Perceptron. It lets every example program share one conversion
+// helper instead of each writing its own.
+func Float64Examples(ds Dataset) (inputs, targets [][]float64) {
+	ds.Reset()
+	for {
+		features, label, ok := ds.Next()
+		if !ok {
+			break
+		}
+		input := make([]float64, len(features))
+		for i, v := range features {
+			input[i] = float64(v)
+		}
+		inputs = append(inputs, input)
+		targets = append(targets, []float64{float64(label)})
+	}
+	return inputs, targets
+}
+
+/* ### File-backed datasets */
+
+// sliceDataset serves pre-loaded examples from memory. CSVDataset,
+// LIBSVMDataset, and TrainTestSplit all build their results on top of it.
+type sliceDataset struct {
+	examples []sliceExample
+	i        int
+}
+
+type sliceExample struct {
+	features []float32
+	label    int32
+}
+
+func (d *sliceDataset) Reset() {
+	d.i = 0
+}
+
+func (d *sliceDataset) Next() ([]float32, int32, bool) {
+	if d.i >= len(d.examples) {
+		return nil, 0, false
+	}
+	e := d.examples[d.i]
+	d.i++
+	return e.features, e.label, true
+}
+
+// CSVDataset loads labeled examples from a CSV file, one example per line,
+// feature columns first and the integer label in the last column.
+func CSVDataset(path string) (Dataset, error) {
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var examples []sliceExample
+	for n, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		features := make([]float32, len(fields)-1)
+		for i, field := range fields[:len(fields)-1] {
+			v, err := strconv.ParseFloat(strings.TrimSpace(field), 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid feature %q: %w", path, n+1, field, err)
+			}
+			features[i] = float32(v)
+		}
+		label, err := strconv.ParseInt(strings.TrimSpace(fields[len(fields)-1]), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid label %q: %w", path, n+1, fields[len(fields)-1], err)
+		}
+		examples = append(examples, sliceExample{features: features, label: int32(label)})
+	}
+	return &sliceDataset{examples: examples}, nil
+}
+
+// LIBSVMDataset loads labeled examples from a file in the sparse LIBSVM
+// format: "label index:value index:value ...", one example per line.
+// Indices are 1-based and features default to 0 where absent.
+func LIBSVMDataset(path string) (Dataset, error) {
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var examples []sliceExample
+	for n, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		label, err := strconv.ParseInt(fields[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid label %q: %w", path, n+1, fields[0], err)
+		}
+
+		var maxIndex int
+		pairs := make(map[int]float32, len(fields)-1)
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, ":", 2)
+			index, err := strconv.Atoi(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid feature index %q: %w", path, n+1, parts[0], err)
+			}
+			if index < 1 {
+				return nil, fmt.Errorf("%s:%d: invalid feature index %q: must be >= 1", path, n+1, parts[0])
+			}
+			value, err := strconv.ParseFloat(parts[1], 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid feature value %q: %w", path, n+1, parts[1], err)
+			}
+			pairs[index] = float32(value)
+			if index > maxIndex {
+				maxIndex = index
+			}
+		}
+
+		features := make([]float32, maxIndex)
+		for index, value := range pairs {
+			features[index-1] = value
+		}
+		examples = append(examples, sliceExample{features: features, label: int32(label)})
+	}
+	return &sliceDataset{examples: examples}, nil
+}
+
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+/* ### Splitting and reporting */
+
+// TrainTestSplit drains ds and randomly splits its examples into a
+// training and a test Dataset, with the given ratio (0 < ratio < 1) of
+// examples going to the training set.
+func TrainTestSplit(ds Dataset, ratio float64) (trainSet, testSet Dataset) {
+	ds.Reset()
+	var examples []sliceExample
+	for {
+		features, label, ok := ds.Next()
+		if !ok {
+			break
+		}
+		examples = append(examples, sliceExample{features: features, label: label})
+	}
+
+	rand.Shuffle(len(examples), func(i, j int) {
+		examples[i], examples[j] = examples[j], examples[i]
+	})
+
+	cut := int(float64(len(examples)) * ratio)
+	return &sliceDataset{examples: examples[:cut]}, &sliceDataset{examples: examples[cut:]}
+}
+
+// ConfusionMatrix tallies a binary classifier's predictions against the
+// true labels (0 or 1), the basis for every standard classification metric.
+type ConfusionMatrix struct {
+	TruePositive, TrueNegative, FalsePositive, FalseNegative int32
+}
+
+// Add records one more prediction against its true label.
+func (cm *ConfusionMatrix) Add(predicted, actual int32) {
+	switch {
+	case predicted == 1 && actual == 1:
+		cm.TruePositive++
+	case predicted == 0 && actual == 0:
+		cm.TrueNegative++
+	case predicted == 1 && actual == 0:
+		cm.FalsePositive++
+	case predicted == 0 && actual == 1:
+		cm.FalseNegative++
+	}
+}
+
+// Accuracy returns the fraction of predictions that matched the true label.
+func (cm ConfusionMatrix) Accuracy() float64 {
+	total := cm.TruePositive + cm.TrueNegative + cm.FalsePositive + cm.FalseNegative
+	if total == 0 {
+		return 0
+	}
+	correct := cm.TruePositive + cm.TrueNegative
+	return float64(correct) / float64(total)
+}
+
+func (cm ConfusionMatrix) String() string {
+	return fmt.Sprintf(
+		"TP=%d TN=%d FP=%d FN=%d accuracy=%.1f%%",
+		cm.TruePositive, cm.TrueNegative, cm.FalsePositive, cm.FalseNegative, cm.Accuracy()*100,
+	)
+}
@@ -0,0 +1,166 @@
+package dataset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func drainAll(t *testing.T, ds Dataset) ([][]float32, []int32) {
+	t.Helper()
+	var features [][]float32
+	var labels []int32
+	ds.Reset()
+	for {
+		f, l, ok := ds.Next()
+		if !ok {
+			break
+		}
+		features = append(features, f)
+		labels = append(labels, l)
+	}
+	return features, labels
+}
+
+func TestCSVDatasetWellFormed(t *testing.T) {
+	path := writeFile(t, "1,2,1\n3,4,0\n")
+	ds, err := CSVDataset(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	features, labels := drainAll(t, ds)
+	want := [][]float32{{1, 2}, {3, 4}}
+	for i, f := range features {
+		if f[0] != want[i][0] || f[1] != want[i][1] {
+			t.Errorf("row %d: got %v, want %v", i, f, want[i])
+		}
+	}
+	if labels[0] != 1 || labels[1] != 0 {
+		t.Errorf("labels = %v, want [1 0]", labels)
+	}
+}
+
+func TestCSVDatasetSkipsBlankLines(t *testing.T) {
+	path := writeFile(t, "1,2,1\n\n3,4,0\n")
+	ds, err := CSVDataset(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	features, _ := drainAll(t, ds)
+	if len(features) != 2 {
+		t.Fatalf("got %d rows, want 2", len(features))
+	}
+}
+
+func TestCSVDatasetMalformedFeature(t *testing.T) {
+	path := writeFile(t, "1,notanumber,1\n")
+	if _, err := CSVDataset(path); err == nil {
+		t.Fatal("expected an error for a non-numeric feature, got nil")
+	}
+}
+
+func TestCSVDatasetMalformedLabel(t *testing.T) {
+	path := writeFile(t, "1,2,notanumber\n")
+	if _, err := CSVDataset(path); err == nil {
+		t.Fatal("expected an error for a non-numeric label, got nil")
+	}
+}
+
+func TestLIBSVMDatasetSparse(t *testing.T) {
+	path := writeFile(t, "1 1:0.5 3:2\n0 2:-1\n")
+	ds, err := LIBSVMDataset(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	features, labels := drainAll(t, ds)
+
+	if len(features[0]) != 3 || features[0][0] != 0.5 || features[0][1] != 0 || features[0][2] != 2 {
+		t.Errorf("row 0 features = %v, want [0.5 0 2]", features[0])
+	}
+	if len(features[1]) != 2 || features[1][0] != 0 || features[1][1] != -1 {
+		t.Errorf("row 1 features = %v, want [0 -1]", features[1])
+	}
+	if labels[0] != 1 || labels[1] != 0 {
+		t.Errorf("labels = %v, want [1 0]", labels)
+	}
+}
+
+func TestLIBSVMDatasetDuplicateIndexKeepsLastValue(t *testing.T) {
+	path := writeFile(t, "1 1:1 1:2\n")
+	ds, err := LIBSVMDataset(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	features, _ := drainAll(t, ds)
+	if features[0][0] != 2 {
+		t.Errorf("feature 1 = %v, want 2 (the later value should win)", features[0][0])
+	}
+}
+
+func TestLIBSVMDatasetRejectsIndexZero(t *testing.T) {
+	path := writeFile(t, "1 0:1.0\n")
+	if _, err := LIBSVMDataset(path); err == nil {
+		t.Fatal("expected an error for feature index 0, got nil")
+	}
+}
+
+func TestLIBSVMDatasetRejectsNegativeIndex(t *testing.T) {
+	path := writeFile(t, "1 -1:1.0\n")
+	if _, err := LIBSVMDataset(path); err == nil {
+		t.Fatal("expected an error for a negative feature index, got nil")
+	}
+}
+
+func TestLIBSVMDatasetMalformedLabel(t *testing.T) {
+	path := writeFile(t, "notanumber 1:1.0\n")
+	if _, err := LIBSVMDataset(path); err == nil {
+		t.Fatal("expected an error for a non-numeric label, got nil")
+	}
+}
+
+func TestLIBSVMDatasetMalformedValue(t *testing.T) {
+	path := writeFile(t, "1 1:notanumber\n")
+	if _, err := LIBSVMDataset(path); err == nil {
+		t.Fatal("expected an error for a non-numeric feature value, got nil")
+	}
+}
+
+func TestCircleDataset(t *testing.T) {
+	ds := &CircleDataset{Radius: 50, Size: 200}
+	features, labels := drainAll(t, ds)
+	for i, f := range features {
+		x, y := f[0], f[1]
+		inside := x*x+y*y < 50*50
+		wantLabel := int32(0)
+		if inside {
+			wantLabel = 1
+		}
+		if labels[i] != wantLabel {
+			t.Errorf("point %v: label = %d, want %d", f, labels[i], wantLabel)
+		}
+	}
+}
+
+func TestTrainTestSplit(t *testing.T) {
+	ds := &LinearSeparableDataset{A: 1, B: 0, Size: 100}
+	trainSet, testSet := TrainTestSplit(ds, 0.75)
+
+	trainFeatures, _ := drainAll(t, trainSet)
+	testFeatures, _ := drainAll(t, testSet)
+
+	if len(trainFeatures) != 75 {
+		t.Errorf("train set has %d examples, want 75", len(trainFeatures))
+	}
+	if len(testFeatures) != 25 {
+		t.Errorf("test set has %d examples, want 25", len(testFeatures))
+	}
+}
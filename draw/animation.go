@@ -0,0 +1,77 @@
+package draw
+
+import (
+	"image"
+	"image/color/palette"
+	stddraw "image/draw"
+	"image/gif"
+	"os"
+)
+
+// AnimatedCanvas accumulates a sequence of frames drawn with DrawPoint and
+// DrawLinearFunction, so that training can capture the decision boundary's
+// evolution and save it as a single animated GIF with SaveGIF.
+type AnimatedCanvas struct {
+	path  string
+	delay int // hundredths of a second between frames, as image/gif wants it
+
+	img    *image.RGBA
+	frames []*image.Paletted
+}
+
+// NewAnimatedCanvas creates an animated canvas that will be saved to path,
+// played back at the given frames per second.
+func NewAnimatedCanvas(path string, fps int) *AnimatedCanvas {
+	delay := 100 / fps
+	if delay < 1 {
+		delay = 1
+	}
+	return &AnimatedCanvas{
+		path:  path,
+		delay: delay,
+		img:   blankImage(),
+	}
+}
+
+// DrawPoint draws a single point onto the current frame, same as Canvas.DrawPoint.
+func (c *AnimatedCanvas) DrawPoint(x, y int32, above bool) {
+	col := belowColor
+	if above {
+		col = aboveColor
+	}
+	setPixel(c.img, x, y, col)
+}
+
+// DrawLinearFunction draws a separation line onto the current frame, same as Canvas.DrawLinearFunction.
+func (c *AnimatedCanvas) DrawLinearFunction(a, b int32) {
+	for x := int32(-size / 2); x <= size/2; x++ {
+		setPixel(c.img, x, a*x+b, lineColor)
+	}
+}
+
+// Frame snapshots everything drawn since the last Frame call as one GIF
+// frame, then clears the canvas so the next frame starts blank.
+func (c *AnimatedCanvas) Frame() {
+	frame := image.NewPaletted(c.img.Bounds(), palette.Plan9)
+	stddraw.Draw(frame, frame.Bounds(), c.img, image.Point{}, stddraw.Src)
+	c.frames = append(c.frames, frame)
+	c.img = blankImage()
+}
+
+// SaveGIF writes every captured frame out as a single animated GIF.
+func (c *AnimatedCanvas) SaveGIF() {
+	f, err := os.Create(c.path)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	g := &gif.GIF{}
+	for _, frame := range c.frames {
+		g.Image = append(g.Image, frame)
+		g.Delay = append(g.Delay, c.delay)
+	}
+	if err := gif.EncodeAll(f, g); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,94 @@
+// Package draw renders the perceptron demos' training data and decision
+// boundary as a simple bitmap, matching the -100..100 coordinate range used
+// throughout perceptron.go.
+package draw
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+)
+
+// size covers the full -100..100 range the demos generate points in,
+// plus the endpoints themselves.
+const size = 201
+
+var (
+	background = color.White
+	lineColor  = color.Black
+	belowColor = color.RGBA{R: 0, G: 0, B: 255, A: 255}
+	aboveColor = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+)
+
+// Canvas is a single bitmap that DrawPoint and DrawLinearFunction paint
+// onto, saved to a PNG file by Save.
+type Canvas struct {
+	img *image.RGBA
+}
+
+// NewCanvas creates a blank canvas covering x and y from -100 to 100.
+func NewCanvas() *Canvas {
+	return &Canvas{img: blankImage()}
+}
+
+func blankImage() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.Set(x, y, background)
+		}
+	}
+	return img
+}
+
+// toPixel maps a demo coordinate in -100..100 to a 0..size-1 pixel index.
+// setPixel then flips y so that larger values point up rather than down.
+func toPixel(v int32) (int, bool) {
+	p := int(v) + size/2
+	return p, p >= 0 && p < size
+}
+
+func setPixel(img *image.RGBA, x, y int32, c color.Color) {
+	px, okX := toPixel(x)
+	py, okY := toPixel(y)
+	if !okX || !okY {
+		return
+	}
+	img.Set(px, size-1-py, c)
+}
+
+// DrawPoint draws a single point. above selects the colour: points the
+// perceptron classified as being above the line are drawn in a different
+// colour from those classified as below it.
+func (c *Canvas) DrawPoint(x, y int32, above bool) {
+	col := belowColor
+	if above {
+		col = aboveColor
+	}
+	setPixel(c.img, x, y, col)
+}
+
+// DrawLinearFunction draws the separation line y = a*x + b across the
+// whole width of the canvas.
+func (c *Canvas) DrawLinearFunction(a, b int32) {
+	for x := int32(-size / 2); x <= size/2; x++ {
+		setPixel(c.img, x, a*x+b, lineColor)
+	}
+}
+
+// Save writes the canvas to ./result.png.
+func (c *Canvas) Save() {
+	saveImage(c.img, "result.png")
+}
+
+func saveImage(img image.Image, path string) {
+	f, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,39 @@
+// Command csvclassifier loads labeled points from points.csv with
+// dataset.CSVDataset and trains a single-layer network on them, to
+// exercise the CSV loader against a real file instead of only generated
+// data.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/appliedgo/perceptron/dataset"
+	"github.com/appliedgo/perceptron/neural"
+)
+
+func main() {
+	ds, err := dataset.CSVDataset("points.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	trainSet, testSet := dataset.TrainTestSplit(ds, 0.75)
+
+	inputs, targets := dataset.Float64Examples(trainSet)
+	net := neural.NewNetwork([]int{2, 1}, neural.Sigmoid)
+	net.Train(inputs, targets, 2000, 0.5)
+
+	testInputs, testTargets := dataset.Float64Examples(testSet)
+	var correct int
+	for i, input := range testInputs {
+		out := net.Forward(input)[0]
+		predicted := 0.0
+		if out >= 0.5 {
+			predicted = 1
+		}
+		if predicted == testTargets[i][0] {
+			correct++
+		}
+	}
+	fmt.Printf("%d/%d test points classified correctly\n", correct, len(testInputs))
+}
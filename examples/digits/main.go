@@ -0,0 +1,61 @@
+// Command digits trains a multi-layer network to recognize a handful of
+// hand-drawn digits encoded as small pixel grids, demonstrating the neural
+// package on a multi-class classification task rather than the simple
+// binary split the original perceptron handled.
+package main
+
+import (
+	"fmt"
+
+	"github.com/appliedgo/perceptron/neural"
+)
+
+// Each digit is a 3x5 grid of pixels (on/off), flattened row-major into 15
+// inputs. The shapes are deliberately blocky; the point is to show
+// multi-class training, not to recognize real handwriting.
+var digits = map[int][]float64{
+	0: {
+		1, 1, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 1, 1,
+	},
+	1: {
+		0, 1, 0,
+		0, 1, 0,
+		0, 1, 0,
+		0, 1, 0,
+		0, 1, 0,
+	},
+	2: {
+		1, 1, 1,
+		0, 0, 1,
+		1, 1, 1,
+		1, 0, 0,
+		1, 1, 1,
+	},
+}
+
+// oneHot returns a 3-element target vector with a 1 at index n.
+func oneHot(n int) []float64 {
+	t := make([]float64, len(digits))
+	t[n] = 1
+	return t
+}
+
+func main() {
+	var inputs, targets [][]float64
+	for n := 0; n < len(digits); n++ {
+		inputs = append(inputs, digits[n])
+		targets = append(targets, oneHot(n))
+	}
+
+	net := neural.NewNetwork([]int{15, 8, len(digits)}, neural.Sigmoid)
+	net.Train(inputs, targets, 5000, 0.5)
+
+	for n, pixels := range digits {
+		out := net.Forward(pixels)
+		fmt.Printf("digit %d classified as %v\n", n, out)
+	}
+}
@@ -0,0 +1,41 @@
+// Command lineclassifier re-implements the original above/below-the-line
+// demo from the top-level perceptron on top of the neural package, to show
+// that a single-layer Network with a sigmoid activation reproduces the
+// same classification behaviour as the hand-rolled Heaviside perceptron.
+// It trains on the very same dataset.LinearSeparableDataset the root demo
+// uses, rather than a second, independent copy of the teacher.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/appliedgo/perceptron/dataset"
+	"github.com/appliedgo/perceptron/neural"
+)
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+	a := rand.Int31n(11) - 6
+	b := rand.Int31n(101) - 51
+
+	inputs, targets := dataset.Float64Examples(&dataset.LinearSeparableDataset{A: a, B: b, Size: 1000})
+
+	net := neural.NewNetwork([]int{2, 1}, neural.Sigmoid)
+	net.Train(inputs, targets, 1, 0.1)
+
+	testInputs, testTargets := dataset.Float64Examples(&dataset.LinearSeparableDataset{A: a, B: b, Size: 100})
+	var correct int
+	for i, input := range testInputs {
+		out := net.Forward(input)[0]
+		predicted := 0.0
+		if out >= 0.5 {
+			predicted = 1
+		}
+		if predicted == testTargets[i][0] {
+			correct++
+		}
+	}
+	fmt.Printf("%d%% of the answers were correct.\n", correct)
+}
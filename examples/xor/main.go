@@ -0,0 +1,26 @@
+// Command xor trains a small multi-layer network on the XOR problem, which
+// a single perceptron cannot learn because XOR is not linearly separable.
+// It exists to demonstrate the capability the neural package adds on top
+// of the original single-neuron perceptron. It trains on the same
+// dataset.XORDataset the dataset package ships, rather than a second,
+// independent copy of the four XOR examples.
+package main
+
+import (
+	"fmt"
+
+	"github.com/appliedgo/perceptron/dataset"
+	"github.com/appliedgo/perceptron/neural"
+)
+
+func main() {
+	inputs, targets := dataset.Float64Examples(&dataset.XORDataset{})
+
+	net := neural.NewNetwork([]int{2, 4, 1}, neural.Sigmoid)
+	net.Train(inputs, targets, 10000, 0.5)
+
+	for _, in := range inputs {
+		out := net.Forward(in)
+		fmt.Printf("%v => %.3f\n", in, out[0])
+	}
+}
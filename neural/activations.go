@@ -0,0 +1,58 @@
+package neural
+
+import "math"
+
+// ActivationFunc bundles an activation function with its derivative so that
+// a Network can run both the forward pass and backpropagation without
+// knowing which concrete function it was built with.
+//
+// Fn computes the activated value from the pre-activation sum z.
+// Derivative computes df/dz, but to keep the common cases cheap it receives
+// both z and the already-computed a = Fn(z): sigmoid and tanh only need a,
+// while ReLU only needs z.
+type ActivationFunc struct {
+	Name       string
+	Fn         func(z float64) float64
+	Derivative func(z, a float64) float64
+}
+
+// Sigmoid squashes its input into (0, 1). Its derivative is the classic
+// a*(1-a) shortcut, expressed in terms of the cached activation.
+var Sigmoid = ActivationFunc{
+	Name: "sigmoid",
+	Fn: func(z float64) float64 {
+		return 1 / (1 + math.Exp(-z))
+	},
+	Derivative: func(z, a float64) float64 {
+		return a * (1 - a)
+	},
+}
+
+// Tanh squashes its input into (-1, 1). Its derivative simplifies to
+// 1-a^2 in terms of the cached activation.
+var Tanh = ActivationFunc{
+	Name: "tanh",
+	Fn:   math.Tanh,
+	Derivative: func(z, a float64) float64 {
+		return 1 - a*a
+	},
+}
+
+// ReLU passes positive values through unchanged and clips negative ones to
+// zero. Its derivative is the step function evaluated on z itself, since a
+// alone cannot tell a zero input from a negative one.
+var ReLU = ActivationFunc{
+	Name: "relu",
+	Fn: func(z float64) float64 {
+		if z > 0 {
+			return z
+		}
+		return 0
+	},
+	Derivative: func(z, a float64) float64 {
+		if z > 0 {
+			return 1
+		}
+		return 0
+	},
+}
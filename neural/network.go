@@ -0,0 +1,135 @@
+// Package neural implements small multi-layer, feed-forward networks
+// trained with backpropagation. It generalizes the single-neuron
+// Heaviside-step perceptron in the parent package to arbitrarily many
+// layers and pluggable activation functions, while staying small enough to
+// read in one sitting.
+package neural
+
+import (
+	"math"
+	"math/rand"
+)
+
+// layer holds the trainable parameters of one fully-connected layer
+// together with the values cached during the last forward pass, which
+// backpropagation needs to compute gradients.
+type layer struct {
+	weights [][]float64 // weights[j][i]: weight from input i to neuron j
+	biases  []float64   // biases[j]
+
+	input []float64 // a_prev, the activations fed into this layer
+	z     []float64 // pre-activation sums, one per neuron
+	a     []float64 // post-activation outputs, one per neuron
+}
+
+func newLayer(fanIn, fanOut int, activation ActivationFunc) *layer {
+	// Scale the initial weights by the fan-in so that signals neither
+	// explode nor vanish as they pass through deeper networks: He scaling
+	// for ReLU, Xavier/Glorot scaling for the saturating activations.
+	scale := math.Sqrt(1 / float64(fanIn))
+	if activation.Name == "relu" {
+		scale = math.Sqrt(2 / float64(fanIn))
+	}
+
+	weights := make([][]float64, fanOut)
+	for j := range weights {
+		weights[j] = make([]float64, fanIn)
+		for i := range weights[j] {
+			weights[j][i] = (rand.Float64()*2 - 1) * scale
+		}
+	}
+	return &layer{
+		weights: weights,
+		biases:  make([]float64, fanOut),
+	}
+}
+
+func (l *layer) forward(input []float64, activation ActivationFunc) []float64 {
+	l.input = input
+	l.z = make([]float64, len(l.weights))
+	l.a = make([]float64, len(l.weights))
+	for j, w := range l.weights {
+		sum := l.biases[j]
+		for i, in := range input {
+			sum += w[i] * in
+		}
+		l.z[j] = sum
+		l.a[j] = activation.Fn(sum)
+	}
+	return l.a
+}
+
+// Network is a feed-forward network made of one or more fully-connected
+// layers, all sharing the same activation function.
+type Network struct {
+	layers     []*layer
+	activation ActivationFunc
+}
+
+// NewNetwork builds a network whose layer sizes are given by layers, e.g.
+// []int{2, 4, 1} for two inputs, one hidden layer of four neurons, and one
+// output. Every neuron uses the given activation function.
+func NewNetwork(layers []int, activation ActivationFunc) *Network {
+	n := &Network{activation: activation}
+	for l := 1; l < len(layers); l++ {
+		n.layers = append(n.layers, newLayer(layers[l-1], layers[l], activation))
+	}
+	return n
+}
+
+// Forward runs input through every layer in turn and returns the final
+// layer's activations.
+func (n *Network) Forward(input []float64) []float64 {
+	out := input
+	for _, l := range n.layers {
+		out = l.forward(out, n.activation)
+	}
+	return out
+}
+
+// Train runs backpropagation for the given number of epochs, presenting
+// every (input, target) pair once per epoch and adjusting weights and
+// biases after each one.
+//
+// Per layer l (L being the output layer), with squared-error loss:
+//
+//	delta_L = (a_L - target) * f'(z_L)
+//	delta_l = (W_{l+1}^T * delta_{l+1}) * f'(z_l)
+//	W[j][i] -= rate * delta[j] * a_prev[i]
+//	b[j]    -= rate * delta[j]
+func (n *Network) Train(inputs, targets [][]float64, epochs int, rate float64) {
+	for e := 0; e < epochs; e++ {
+		for s, input := range inputs {
+			n.Forward(input)
+			n.backward(targets[s], rate)
+		}
+	}
+}
+
+func (n *Network) backward(target []float64, rate float64) {
+	deltas := make([][]float64, len(n.layers))
+	for l := len(n.layers) - 1; l >= 0; l-- {
+		layer := n.layers[l]
+		delta := make([]float64, len(layer.a))
+		for j := range layer.a {
+			if l == len(n.layers)-1 {
+				delta[j] = (layer.a[j] - target[j]) * n.activation.Derivative(layer.z[j], layer.a[j])
+			} else {
+				var sum float64
+				for k, w := range n.layers[l+1].weights {
+					sum += w[j] * deltas[l+1][k]
+				}
+				delta[j] = sum * n.activation.Derivative(layer.z[j], layer.a[j])
+			}
+		}
+		deltas[l] = delta
+	}
+	for l, layer := range n.layers {
+		for j, w := range layer.weights {
+			for i := range w {
+				w[i] -= rate * deltas[l][j] * layer.input[i]
+			}
+			layer.biases[j] -= rate * deltas[l][j]
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package neural
+
+import (
+	"math"
+	"testing"
+)
+
+// numericalDerivative approximates f'(z) with central differences, used as
+// a ground truth to check each ActivationFunc.Derivative against.
+func numericalDerivative(fn func(float64) float64, z float64) float64 {
+	const h = 1e-6
+	return (fn(z+h) - fn(z-h)) / (2 * h)
+}
+
+func TestActivationDerivatives(t *testing.T) {
+	cases := []ActivationFunc{Sigmoid, Tanh, ReLU}
+
+	for _, act := range cases {
+		for _, z := range []float64{-3, -1, -0.5, 0.5, 1, 3} {
+			a := act.Fn(z)
+			got := act.Derivative(z, a)
+			want := numericalDerivative(act.Fn, z)
+			if math.Abs(got-want) > 1e-4 {
+				t.Errorf("%s: Derivative(%v, %v) = %v, want ~%v", act.Name, z, a, got, want)
+			}
+		}
+	}
+}
+
+func TestNetworkXOR(t *testing.T) {
+	inputs := [][]float64{
+		{0, 0},
+		{0, 1},
+		{1, 0},
+		{1, 1},
+	}
+	targets := [][]float64{
+		{0},
+		{1},
+		{1},
+		{0},
+	}
+
+	net := NewNetwork([]int{2, 4, 1}, Sigmoid)
+	net.Train(inputs, targets, 10000, 0.5)
+
+	for i, in := range inputs {
+		out := net.Forward(in)[0]
+		want := targets[i][0]
+		above := out >= 0.5
+		wantAbove := want >= 0.5
+		if above != wantAbove {
+			t.Errorf("XOR(%v) = %.3f, want on the %v side of 0.5", in, out, wantAbove)
+		}
+	}
+}
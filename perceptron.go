@@ -10,164 +10,222 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/appliedgo/perceptron/dataset"
 	"github.com/appliedgo/perceptron/draw"
 )
 
 /*
 ### The perceptron
 
-First we define the perceptron. A new perceptron uses random weights and biases that will be modified during the training process. The perceptron performs two tasks:
+First we define the perceptron. A new perceptron uses random weights that will be modified during the training process. The perceptron performs two tasks:
 
 * Process input signals
 * Adjust the input weights as instructed by the "trainer".
 
 */
 
-// Our perceptron is a simple struct that holds the input weights and the bias.
+// Our perceptron holds the input weights, an always-on bias input, and the
+// pluggable activation function and learning-rate schedule that shape how
+// it classifies and learns.
 type Perceptron struct {
-	weights []float32
-	bias    float32
+	// weights holds one weight per input, plus one extra trailing weight
+	// for the bias. Treating the bias as just another weight, fed by an
+	// input that is always 1, is the canonical formulation and keeps
+	// Process and Adjust from needing a special case for it.
+	weights    []float32
+	activation ActivationFunc
+	rate       float32
+	schedule   LearningRateSchedule
 }
 
-// This is the Heaviside Step function.
-func (p *Perceptron) heaviside(f float32) int32 {
-	if f < 0 {
-		return 0
-	}
-	return 1
-}
-
-// Create a new perceptron with n inputs. Weights and bias are initialized with random values
-// between -1 and 1.
-func NewPerceptron(n int32) *Perceptron {
+// Create a new perceptron with n inputs, the given activation function, and
+// a base learning rate shaped by schedule. Weights (including the trailing
+// bias weight) are initialized with random values between -1 and 1.
+func NewPerceptron(n int32, activation ActivationFunc, rate float32, schedule LearningRateSchedule) *Perceptron {
 	var i int32
-	w := make([]float32, n, n)
-	for i = 0; i < n; i++ {
+	w := make([]float32, n+1, n+1)
+	for i = 0; i < n+1; i++ {
 		w[i] = rand.Float32()*2 - 1
 	}
 	return &Perceptron{
-		weights: w,
-		bias:    rand.Float32()*2 - 1,
+		weights:    w,
+		activation: activation,
+		rate:       rate,
+		schedule:   schedule,
 	}
 }
 
+// biasWeight returns the index of the trailing weight that belongs to the
+// always-on bias input.
+func (p *Perceptron) biasWeight() int {
+	return len(p.weights) - 1
+}
+
 // `Process` implements the core functionality of the perceptron. It weighs the input signals,
-// sums them up, adds the bias, and runs the result through the Heaviside Step function.
-// (The return value could be a boolean but is an int32 instead, so that we can directly
-// use the value for adjusting the perceptron.)
-func (p *Perceptron) Process(inputs []int32) int32 {
-	sum := p.bias
+// sums them up, adds the weighted bias input, and runs the result through the configured
+// activation function.
+func (p *Perceptron) Process(inputs []float32) float32 {
+	sum := p.weights[p.biasWeight()] // the bias input is always 1
 	for i, input := range inputs {
-		sum += float32(input) * p.weights[i]
+		sum += input * p.weights[i]
 	}
-	return p.heaviside(sum)
+	return p.activation.Fn(sum)
 }
 
-// During the learning phase, the perceptron adjusts the weights and the bias based on how much the perceptron's answer differs from the correct answer.
-func (p *Perceptron) Adjust(inputs []int32, delta int32, learningRate float32) {
+// Predict classifies inputs as 1 or 0. Unlike Process, whose raw output range depends on the
+// configured activation (e.g. Sigmoid's (0, 1) vs. Sign's {-1, 1}), Predict always returns a
+// class label, letting callers compare it against a dataset's label directly.
+func (p *Perceptron) Predict(inputs []float32) int32 {
+	if p.activation.Positive(p.Process(inputs)) {
+		return 1
+	}
+	return 0
+}
+
+// During the learning phase, the perceptron adjusts the weights (including the bias weight)
+// based on how much the perceptron's answer differs from the correct answer. iter identifies
+// the current training iteration so the learning-rate schedule can anneal the rate over time.
+func (p *Perceptron) Adjust(inputs []float32, delta float32, iter int) {
+	rate := p.schedule(p.rate, iter)
 	for i, input := range inputs {
-		p.weights[i] += float32(input) * float32(delta) * learningRate
+		p.weights[i] += input * delta * rate
 	}
-	p.bias += float32(delta) * learningRate
+	p.weights[p.biasWeight()] += delta * rate // the bias input is always 1
 }
 
 /* ### Training
 
-We rule out the case where the line would be vertical. This allows us to specify the line as a linear function equation:
-
-    f(x) = ax + b
-
-Parameter *a* specifies the gradient of the line (that is, how steep the line is), and *b* sets the offset.
-
-By describing the line this way, checking whether a given point is above or below the line becomes very easy. For a point *(x,y)*, if the value of *y* is larger than the result of *f(x)*, then *(x,y)* is above the line.
-
-See these examples:
-
-![Lines expressed through y = ax + b](separationlines.png)
+Training no longer generates its own points. Instead it pulls labeled
+examples from a dataset.Dataset (see the dataset package), which can be the
+classic above/below-the-line teacher, a CSV file, or anything else
+implementing the interface. The examples/lineclassifier demo draws from the
+very same dataset.LinearSeparableDataset to train its single-layer network,
+so the two demos never disagree about what "above the line" means.
 
 */
 
-// *a* and *b* specify the linear function that describes the separation line; see below for details.
-// They are defined at global level because we need them in several places and I do not want to
-// clutter the parameter lists unnecessarily.
-var (
-	a, b int32
-)
+// Function `train` is our teacher. The teacher draws examples from ds and feeds them to the
+// perceptron, once per epoch, comparing the answer against the dataset's label and telling the
+// perceptron how far it is off.
+//
+// If anim is non-nil, train snapshots the 2D decision boundary and the current example onto it
+// every frameEvery iterations, so SaveGIF-ing anim afterwards shows the separator line rotating
+// and translating into place as training progresses.
+func train(p *Perceptron, ds dataset.Dataset, epochs int, anim *draw.AnimatedCanvas, frameEvery int) {
+	iter := 0
+	for e := 0; e < epochs; e++ {
+		ds.Reset()
+		for {
+			features, label, ok := ds.Next()
+			if !ok {
+				break
+			}
+			wrong := trainExample(p, features, label, iter) == 1
+
+			if anim != nil && len(features) == 2 && iter%frameEvery == 0 {
+				a, b := decisionLine(p)
+				anim.DrawLinearFunction(a, b)
+				anim.DrawPoint(int32(features[0]), int32(features[1]), wrong)
+				anim.Frame()
+			}
+			iter++
+		}
+	}
+}
 
-// This function describes the separation line.
-func f(x int32) int32 {
-	return a*x + b
+// decisionLine reads off the line y = a*x + b that a 2-input perceptron's current weights and
+// bias describe, i.e. the points where its weighted sum is exactly zero. It only makes sense for
+// perceptrons with exactly two inputs, which is what the animated training demo uses.
+func decisionLine(p *Perceptron) (a, b int32) {
+	w0, w1, bias := p.weights[0], p.weights[1], p.weights[p.biasWeight()]
+	if w1 == 0 {
+		return 0, 0
+	}
+	return int32(-w0 / w1), int32(-bias / w1)
 }
 
-// Function `isAboveLine` returns 1 if the point *(x,y)* is above the line *y = ax + b*, else 0. This is our teacher's solution manual.
-func isAboveLine(point []int32, f func(int32) int32) int32 {
-	x := point[0]
-	y := point[1]
-	if y > f(x) {
+// trainExample runs a single training step and reports 1 if the
+// perceptron's answer was wrong before adjusting, 0 if it was already
+// correct.
+func trainExample(p *Perceptron, features []float32, label int32, iter int) int32 {
+	wrong := p.Predict(features) != label
+	actual := p.Process(features)
+	expected := float32(label)
+	delta := expected - actual
+
+	// Have the perceptron adjust its internal values accordingly.
+	p.Adjust(features, delta, iter)
+
+	if wrong {
 		return 1
 	}
 	return 0
 }
 
-// Function `train` is our teacher. The teacher generates random test points and feeds them to the perceptron. Then the teacher compares the answer against the solution from the 'solution manual' and tells the perceptron how far it is off.
-func train(p *Perceptron, iters int, rate float32) {
-
-	for i := 0; i < iters; i++ {
-		// Generate a random point between -100 and 100.
-		point := []int32{
-			rand.Int31n(201) - 101,
-			rand.Int31n(201) - 101,
+// TrainWithHistory behaves like train, but returns the number of
+// misclassifications observed at each iteration (across all epochs) so
+// callers can plot how the error rate falls off as training progresses.
+func TrainWithHistory(p *Perceptron, ds dataset.Dataset, epochs int) []int32 {
+	var errors []int32
+	iter := 0
+	for e := 0; e < epochs; e++ {
+		ds.Reset()
+		for {
+			features, label, ok := ds.Next()
+			if !ok {
+				break
+			}
+			errors = append(errors, trainExample(p, features, label, iter))
+			iter++
 		}
-
-		// Feed the point to the perceptron and evaluate the result.
-		actual := p.Process(point)
-		expected := isAboveLine(point, f)
-		delta := expected - actual
-
-		// Have the perceptron adjust its internal values accordingly.
-		p.Adjust(point, delta, rate)
 	}
+	return errors
 }
 
 /*
 ### Showtime!
 
-Now it is time to see how well the perceptron has learned the task. Again we throw random points
-at it, but this time there is no feedback from the teacher. Will the perceptron classify every
-point correctly?
+Now it is time to see how well the perceptron has learned the task. Again we draw examples from a
+Dataset, but this time there is no feedback to the perceptron. Will it classify every example
+correctly?
 */
 
-// This is our test function. It returns the number of correct answers.
-func verify(p *Perceptron) int32 {
-	var correctAnswers int32 = 0
+// This is our test function. It returns a confusion matrix summarizing how the perceptron's
+// answers compared against ds's labels.
+func verify(p *Perceptron, ds dataset.Dataset) dataset.ConfusionMatrix {
+	var cm dataset.ConfusionMatrix
 
 	// Create a new drawing canvas. Both *x* and *y* range from -100 to 100.
 	c := draw.NewCanvas()
 
-	for i := 0; i < 100; i++ {
-		// Generate a random point between -100 and 100.
-		point := []int32{
-			rand.Int31n(201) - 101,
-			rand.Int31n(201) - 101,
+	ds.Reset()
+	for {
+		features, label, ok := ds.Next()
+		if !ok {
+			break
 		}
 
-		// Feed the point to the perceptron and evaluate the result.
-		result := p.Process(point)
-		if result == isAboveLine(point, f) {
-			correctAnswers += 1
-		}
+		// Feed the example to the perceptron and evaluate the result.
+		predicted := p.Predict(features)
+		cm.Add(predicted, label)
 
-		// Draw the point. The colour tells whether the perceptron answered 'is above' or 'is below'.
-		c.DrawPoint(point[0], point[1], result == 1)
+		// Draw the point, if it is 2-dimensional. The colour tells whether the perceptron
+		// answered 'is above' or 'is below'.
+		if len(features) == 2 {
+			c.DrawPoint(int32(features[0]), int32(features[1]), predicted == 1)
+		}
 	}
 
-	// Draw the separation line *y = ax + b*.
-	c.DrawLinearFunction(a, b)
+	// Draw the separation line, if ds is one that has one.
+	if ld, ok := ds.(dataset.LineDataset); ok {
+		a, b := ld.Line()
+		c.DrawLinearFunction(a, b)
+	}
 
 	// Save the image as `./result.png`.
 	c.Save()
 
-	return correctAnswers
+	return cm
 }
 
 // Main: Set up, train, and test the perceptron.
@@ -177,19 +235,40 @@ func main() {
 	// a (the gradient of the line) can vary between -5 and 5,
 	// and b (the offset) between -50 and 50.
 	rand.Seed(time.Now().UnixNano())
-	a = rand.Int31n(11) - 6
-	b = rand.Int31n(101) - 51
+	a := rand.Int31n(11) - 5
+	b := rand.Int31n(101) - 50
+
+	// Create a new perceptron with two inputs (one for x and one for y),
+	// the classic Heaviside step activation, and a constant learning rate.
+	var learningRate float32 = 0.1 // Allowed range: 0 < learning rate <= 1.
+	p := NewPerceptron(2, Step, learningRate, ConstantSchedule)
 
-	// Create a new perceptron with two inputs (one for x and one for y).
-	p := NewPerceptron(2)
+	// Capture every 20th training iteration into an animated GIF, so the separator line can be
+	// watched rotating and translating into place instead of only seeing the final result.
+	anim := draw.NewAnimatedCanvas("training.gif", 10)
 
 	// Start learning.
-	iterations := 1000
-	var learningRate float32 = 0.1 // Allowed range: 0 < learning rate <= 1.
 	// **Try to play with these parameters!**
-	train(p, iterations, learningRate)
+	train(p, &dataset.LinearSeparableDataset{A: a, B: b, Size: 1000}, 1, anim, 20)
+	anim.SaveGIF()
 
 	// Now the perceptron is ready for testing.
-	successRate := verify(p)
-	fmt.Printf("%d%% of the answers were correct.\n", successRate)
+	report := verify(p, &dataset.LinearSeparableDataset{A: a, B: b, Size: 100})
+	fmt.Println(report)
+
+	// Train a second, fresh perceptron with TrainWithHistory to show how the error count
+	// falls off as training progresses, the convergence curve the history is meant to plot.
+	q := NewPerceptron(2, Step, learningRate, ConstantSchedule)
+	history := TrainWithHistory(q, &dataset.LinearSeparableDataset{A: a, B: b, Size: 1000}, 1)
+	firstHalf, secondHalf := errorsIn(history[:len(history)/2]), errorsIn(history[len(history)/2:])
+	fmt.Printf("errors: %d in the first half of training, %d in the second half\n", firstHalf, secondHalf)
+}
+
+// errorsIn sums a TrainWithHistory error history, such as one half of it, into a single count.
+func errorsIn(history []int32) int32 {
+	var total int32
+	for _, e := range history {
+		total += e
+	}
+	return total
 }
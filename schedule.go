@@ -0,0 +1,30 @@
+package main
+
+import "math"
+
+// LearningRateSchedule derives the learning rate to use at a given
+// iteration from the perceptron's configured base rate, so that Adjust can
+// anneal the rate over time instead of applying it unchanged forever.
+type LearningRateSchedule func(base float32, iter int) float32
+
+// ConstantSchedule keeps the base rate unchanged, matching the original
+// perceptron's behaviour.
+func ConstantSchedule(base float32, iter int) float32 {
+	return base
+}
+
+// ExponentialDecaySchedule returns a schedule following rate * exp(-k*iter),
+// decaying quickly at first and leveling off as iter grows.
+func ExponentialDecaySchedule(k float32) LearningRateSchedule {
+	return func(base float32, iter int) float32 {
+		return base * float32(math.Exp(-float64(k)*float64(iter)))
+	}
+}
+
+// InverseTimeDecaySchedule returns a 1/t-style schedule, rate / (1 + k*iter),
+// which decays more gently than exponential decay.
+func InverseTimeDecaySchedule(k float32) LearningRateSchedule {
+	return func(base float32, iter int) float32 {
+		return base / (1 + k*float32(iter))
+	}
+}